@@ -1,17 +1,33 @@
 package main
 
 import (
+	"flag"
 	"fmt"
+	"os"
+	"time"
 
 	"github.com/digso/wrap/strict-lints/spider"
 )
 
+const officialRulesURL = "https://dart.dev/tools/linter-rules"
+
+// fetcher caches responses for an hour so repeated runs (and, later,
+// per-rule detail fetches) don't hammer dart.dev.
+var fetcher spider.Fetcher = spider.NewCachedFetcher("", time.Hour)
+
 func main() {
-	// Parse official docs.
-	const url = "https://dart.dev/tools/linter-rules"
-	rules, err := spider.ParseOfficialAPIs(url)
+	if len(os.Args) > 1 && os.Args[1] == "analysis-options" {
+		writeAnalysisOptions(os.Args[2:])
+		return
+	}
+	listRules()
+}
+
+// listRules prints every scraped rule, one per line, as "index name(tags)".
+func listRules() {
+	rules, err := spider.ParseOfficialAPIs(officialRulesURL, fetcher)
 	if err != nil {
-		fmt.Errorf("failed to parse lint rules from %s: %v", url, err)
+		fmt.Println("failed to parse lint rules from", officialRulesURL, ":", err)
 		return
 	}
 
@@ -19,3 +35,43 @@ func main() {
 		fmt.Println(i, v.String())
 	}
 }
+
+// writeAnalysisOptions scrapes the official rules and emits an
+// analysis_options.yaml to stdout, ready to drop into a strict-lints
+// project. args are the flags following the "analysis-options" subcommand,
+// mirroring the filters and output form spider.Options exposes.
+func writeAnalysisOptions(args []string) {
+	flags := flag.NewFlagSet("analysis-options", flag.ExitOnError)
+	include := flags.String("include", "", `package to chain via "include:", e.g. package:flutter_lints/flutter.yaml`)
+	onlyCore := flags.Bool("only-core", false, "only emit rules in the Core set")
+	onlyFlutter := flags.Bool("only-flutter", false, "only emit rules in the Flutter set")
+	onlyRecommended := flags.Bool("only-recommended", false, "only emit rules in the Recommended set")
+	excludeDeprecated := flags.Bool("exclude-deprecated", true, "omit deprecated rules")
+	excludeRemoved := flags.Bool("exclude-removed", true, "omit removed rules")
+	excludeExperimental := flags.Bool("exclude-experimental", true, "omit experimental rules")
+	asList := flags.Bool("as-list", false, `emit "- rule_name" entries instead of "rule_name: true"`)
+	if err := flags.Parse(args); err != nil {
+		fmt.Println("failed to parse analysis-options flags:", err)
+		return
+	}
+
+	rules, err := spider.ParseOfficialAPIs(officialRulesURL, fetcher)
+	if err != nil {
+		fmt.Println("failed to parse lint rules from", officialRulesURL, ":", err)
+		return
+	}
+
+	opts := spider.Options{
+		Include:             *include,
+		OnlyCore:            *onlyCore,
+		OnlyFlutter:         *onlyFlutter,
+		OnlyRecommended:     *onlyRecommended,
+		ExcludeDeprecated:   *excludeDeprecated,
+		ExcludeRemoved:      *excludeRemoved,
+		ExcludeExperimental: *excludeExperimental,
+		AsList:              *asList,
+	}
+	if err := spider.WriteAnalysisOptions(rules, opts, os.Stdout); err != nil {
+		fmt.Println("failed to write analysis_options.yaml:", err)
+	}
+}
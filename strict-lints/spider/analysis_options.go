@@ -0,0 +1,89 @@
+package spider
+
+import (
+	"fmt"
+	"io"
+)
+
+// Options controls which rules WriteAnalysisOptions emits and how the
+// resulting analysis_options.yaml is formatted.
+type Options struct {
+	// Include, when non-empty, is chained in via the top-level `include:`
+	// key, e.g. "package:flutter_lints/flutter.yaml".
+	Include string
+
+	// OnlyCore, OnlyFlutter and OnlyRecommended restrict the output to
+	// rules belonging to the matching rule set. Leaving all three false
+	// emits every rule regardless of set membership; setting more than one
+	// ORs them together (e.g. OnlyCore+OnlyFlutter emits rules in either
+	// set).
+	OnlyCore        bool
+	OnlyFlutter     bool
+	OnlyRecommended bool
+
+	// ExcludeDeprecated, ExcludeRemoved and ExcludeExperimental drop rules
+	// whose Status matches, regardless of set membership.
+	ExcludeDeprecated   bool
+	ExcludeRemoved      bool
+	ExcludeExperimental bool
+
+	// AsList emits rules as a "- rule_name" sequence instead of the
+	// "rule_name: true" map form used by newer analyzers.
+	AsList bool
+}
+
+// keep reports whether rule survives the filters configured on opts.
+func (opts Options) keep(rule LintRule) bool {
+	if opts.OnlyCore || opts.OnlyFlutter || opts.OnlyRecommended {
+		inSelectedSet := (opts.OnlyCore && rule.Core) ||
+			(opts.OnlyFlutter && rule.Flutter) ||
+			(opts.OnlyRecommended && rule.Recommended)
+		if !inSelectedSet {
+			return false
+		}
+	}
+	switch rule.Status {
+	case STATUS_DEPRECATED:
+		return !opts.ExcludeDeprecated
+	case STATUS_REMOVED:
+		return !opts.ExcludeRemoved
+	case STATUS_EXPERIMENTAL:
+		return !opts.ExcludeExperimental
+	}
+	return true
+}
+
+// WriteAnalysisOptions renders rules as a ready-to-use analysis_options.yaml
+// document to w, honoring opts for rule-set/status filtering, `include:`
+// chaining and the list-vs-map form of the `rules:` block.
+func WriteAnalysisOptions(rules []LintRule, opts Options, w io.Writer) error {
+	if opts.Include != "" {
+		if _, err := fmt.Fprintf(w, "include: %s\n\n", opts.Include); err != nil {
+			return err
+		}
+	}
+
+	if _, err := fmt.Fprintln(w, "linter:"); err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintln(w, "  rules:"); err != nil {
+		return err
+	}
+
+	for _, rule := range rules {
+		if !opts.keep(rule) {
+			continue
+		}
+		var err error
+		if opts.AsList {
+			_, err = fmt.Fprintf(w, "    - %s\n", rule.Name)
+		} else {
+			_, err = fmt.Fprintf(w, "    %s: true\n", rule.Name)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
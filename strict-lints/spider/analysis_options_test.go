@@ -0,0 +1,78 @@
+package spider_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/digso/wrap/strict-lints/spider"
+)
+
+func TestWriteAnalysisOptions(t *testing.T) {
+	rules := []spider.LintRule{
+		{Name: "always_declare_return_types", Core: true},
+		{Name: "avoid_print", Flutter: true},
+		{Name: "no_duplicate_case_values", Status: spider.STATUS_DEPRECATED},
+	}
+
+	var buf strings.Builder
+	opts := spider.Options{Include: "package:flutter_lints/flutter.yaml", ExcludeDeprecated: true}
+	if err := spider.WriteAnalysisOptions(rules, opts, &buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	const expected = `include: package:flutter_lints/flutter.yaml
+
+linter:
+  rules:
+    always_declare_return_types: true
+    avoid_print: true
+`
+	if buf.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, buf.String())
+	}
+}
+
+func TestWriteAnalysisOptionsAsList(t *testing.T) {
+	rules := []spider.LintRule{
+		{Name: "always_declare_return_types", Core: true},
+		{Name: "avoid_print", Flutter: true},
+	}
+
+	var buf strings.Builder
+	opts := spider.Options{OnlyCore: true, AsList: true}
+	if err := spider.WriteAnalysisOptions(rules, opts, &buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	const expected = `linter:
+  rules:
+    - always_declare_return_types
+`
+	if buf.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, buf.String())
+	}
+}
+
+func TestWriteAnalysisOptionsOnlyFlagsAreORed(t *testing.T) {
+	rules := []spider.LintRule{
+		{Name: "always_declare_return_types", Core: true},
+		{Name: "avoid_print", Flutter: true},
+		{Name: "use_string_buffers", Recommended: true},
+		{Name: "avoid_empty_else"},
+	}
+
+	var buf strings.Builder
+	opts := spider.Options{OnlyCore: true, OnlyFlutter: true}
+	if err := spider.WriteAnalysisOptions(rules, opts, &buf); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	const expected = `linter:
+  rules:
+    always_declare_return_types: true
+    avoid_print: true
+`
+	if buf.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, buf.String())
+	}
+}
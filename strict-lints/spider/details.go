@@ -0,0 +1,178 @@
+package spider
+
+import (
+	"bytes"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// Example is a single labeled code snippet from a rule's detail page, such
+// as the paired "BAD:"/"GOOD:" blocks shown under most rules.
+type Example struct {
+	Label string
+	Code  string
+}
+
+// FetchRuleDetails navigates to rule's detail page on dart.dev and fills in
+// its Description, Details, SinceDartSDK, State, Incompatible and BadGood
+// fields.
+// Fields already set by ParseOfficialAPIs (Name, Status, tags) are left
+// untouched. fetcher supplies the page body; pass &HTTPFetcher{} for a
+// plain GET, or a *CachedFetcher to avoid refetching hundreds of pages on
+// every run.
+func FetchRuleDetails(rule *LintRule, fetcher Fetcher) error {
+	const base = "https://dart.dev/tools/linter-rules/"
+
+	body, err := fetcher.Fetch(base + rule.Name)
+	if err != nil {
+		return err
+	}
+
+	document, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	parseRuleDetails(document, rule)
+	return nil
+}
+
+// FetchAllDetails fetches detail pages for every rule concurrently, using up
+// to concurrency workers sharing a single rate limiter so the spider stays
+// polite to dart.dev even when fanning out across hundreds of rules. It
+// returns one error per rule, in the same order as rules, with a nil entry
+// for rules fetched successfully. Pass a *CachedFetcher as fetcher to make
+// repeated fans-out over hundreds of pages cheap and reproducible.
+func FetchAllDetails(rules []LintRule, concurrency int, fetcher Fetcher) []error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	errs := make([]error, len(rules))
+	jobs := make(chan int)
+	limiter := time.NewTicker(100 * time.Millisecond)
+	defer limiter.Stop()
+
+	var wg sync.WaitGroup
+	for w := 0; w < concurrency; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				<-limiter.C
+				errs[i] = FetchRuleDetails(&rules[i], fetcher)
+			}
+		}()
+	}
+
+	for i := range rules {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return errs
+}
+
+// parseRuleDetails extracts detail-page metadata from such http structure,
+// matching the "article > div.content" shape ParseOfficialAPIs already
+// relies on for the list page:
+//
+//	<article>
+//		<div class="content">
+//			<p>Description paragraph.</p>
+//			<p>State: stable</p>
+//			<p>Since: Dart 2.12</p>
+//			<h2 id="details">Details</h2>
+//			<p>...details prose...</p>
+//			<h2 id="incompatible">Incompatible rules</h2>
+//			<ul>
+//				<li><a href="/tools/linter-rules/other_rule"><code>other_rule</code></a></li>
+//			</ul>
+//			<pre><code class="language-dart">// BAD: ...</code></pre>
+//			<pre><code class="language-dart">// GOOD: ...</code></pre>
+//		</div>
+//	</article>
+func parseRuleDetails(document *goquery.Document, rule *LintRule) {
+	section := ""
+	document.Find("article > div.content").Children().Each(func(_ int, selection *goquery.Selection) {
+		switch goquery.NodeName(selection) {
+		case "h2", "h3":
+			section = strings.ToLower(strings.TrimSpace(selection.Text()))
+		case "p":
+			parseDetailParagraph(selection, rule, section)
+		case "ul":
+			if section == "incompatible rules" {
+				selection.Find("a").Each(func(_ int, a *goquery.Selection) {
+					if name := parseName(a); name != "" {
+						rule.Incompatible = append(rule.Incompatible, name)
+					}
+				})
+			}
+		case "pre":
+			code := selection.Find("code").Text()
+			rule.BadGood = append(rule.BadGood, Example{Label: exampleLabel(code), Code: code})
+		}
+	})
+}
+
+// parseDetailParagraph routes a <p> from the detail page's body to the
+// right LintRule field based on its leading label, or to Description/Details
+// depending on which section it falls under.
+func parseDetailParagraph(selection *goquery.Selection, rule *LintRule, section string) {
+	text := strings.TrimSpace(selection.Text())
+	parseLifecycleProse(text, rule)
+
+	switch {
+	case strings.HasPrefix(text, "State:"):
+		rule.State = strings.TrimSpace(strings.TrimPrefix(text, "State:"))
+	case strings.HasPrefix(text, "Since:"):
+		rule.SinceDartSDK = strings.TrimSpace(strings.TrimPrefix(text, "Since:"))
+	case section == "details":
+		if rule.Details != "" {
+			rule.Details += "\n"
+		}
+		rule.Details += text
+	case section == "" && rule.Description == "":
+		rule.Description = text
+	}
+}
+
+var (
+	deprecatedUseRe = regexp.MustCompile(`(?i)deprecated:\s*use\s+([a-zA-Z0-9_]+)`)
+	deprecatedInRe  = regexp.MustCompile(`(?i)deprecated in dart\s+([0-9]+(?:\.[0-9]+)*)`)
+	removedInRe     = regexp.MustCompile(`(?i)removed in dart\s+([0-9]+(?:\.[0-9]+)*)`)
+)
+
+// parseLifecycleProse scans a paragraph of detail-page prose for the same
+// "Deprecated: use X" convention Go's godocfx uses to detect deprecation
+// status in doc comments, plus "deprecated/removed in Dart N.N" phrasing,
+// filling in Replacement, DeprecatedInDartSDK and RemovedInDartSDK when
+// found.
+func parseLifecycleProse(text string, rule *LintRule) {
+	if m := deprecatedUseRe.FindStringSubmatch(text); m != nil {
+		rule.Replacement = m[1]
+	}
+	if m := deprecatedInRe.FindStringSubmatch(text); m != nil {
+		rule.DeprecatedInDartSDK = m[1]
+	}
+	if m := removedInRe.FindStringSubmatch(text); m != nil {
+		rule.RemovedInDartSDK = m[1]
+	}
+}
+
+// exampleLabel guesses a BadGood label from its leading code comment.
+func exampleLabel(code string) string {
+	switch {
+	case strings.HasPrefix(strings.TrimSpace(code), "// BAD"):
+		return "BAD"
+	case strings.HasPrefix(strings.TrimSpace(code), "// GOOD"):
+		return "GOOD"
+	default:
+		return ""
+	}
+}
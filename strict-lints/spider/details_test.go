@@ -0,0 +1,46 @@
+package spider_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/digso/wrap/strict-lints/spider"
+)
+
+// fixtureFetcher serves a saved HTML file from testdata regardless of the
+// requested URL, so detail-page parsing can be exercised without a live
+// dart.dev request.
+type fixtureFetcher struct {
+	path string
+}
+
+func (f fixtureFetcher) Fetch(url string) ([]byte, error) {
+	return os.ReadFile(f.path)
+}
+
+func TestFetchRuleDetails(t *testing.T) {
+	rule := spider.LintRule{Name: "always_declare_return_types"}
+	err := spider.FetchRuleDetails(&rule, fixtureFetcher{"testdata/detail-always_declare_return_types.html"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if rule.Description != "Declare method return types." {
+		t.Errorf("unexpected Description: %q", rule.Description)
+	}
+	if rule.State != "stable" {
+		t.Errorf("unexpected State: %q", rule.State)
+	}
+	if rule.SinceDartSDK != "Dart 2.12" {
+		t.Errorf("unexpected SinceDartSDK: %q", rule.SinceDartSDK)
+	}
+	if rule.Details != "Types tell the reader what to expect and the analyzer what to check." {
+		t.Errorf("unexpected Details: %q", rule.Details)
+	}
+	if len(rule.Incompatible) != 1 || rule.Incompatible[0] != "avoid_return_types_on_setters" {
+		t.Errorf("unexpected Incompatible: %v", rule.Incompatible)
+	}
+	if len(rule.BadGood) != 2 || rule.BadGood[0].Label != "BAD" || rule.BadGood[1].Label != "GOOD" {
+		t.Errorf("unexpected BadGood: %v", rule.BadGood)
+	}
+}
@@ -0,0 +1,247 @@
+package spider
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Fetcher abstracts how the spider retrieves a URL's body, so callers can
+// swap in caching, rate limiting or test doubles without touching the
+// parsing code.
+type Fetcher interface {
+	Fetch(url string) ([]byte, error)
+}
+
+// HTTPFetcher is the default Fetcher, a thin wrapper around http.Client.
+type HTTPFetcher struct {
+	// Client is used to make requests. If nil, http.DefaultClient is used.
+	Client *http.Client
+}
+
+func (f *HTTPFetcher) client() *http.Client {
+	if f.Client != nil {
+		return f.Client
+	}
+	return http.DefaultClient
+}
+
+// Fetch performs a plain GET and returns the response body.
+func (f *HTTPFetcher) Fetch(url string) ([]byte, error) {
+	response, err := f.client().Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status fetching %s: %s", url, response.Status)
+	}
+	return io.ReadAll(response.Body)
+}
+
+// cacheEntry is the sidecar metadata CachedFetcher persists alongside a
+// cached response body, so it can make a conditional request and know when
+// the cached copy is still within MaxAge or the origin's own advertised
+// Cache-Control max-age.
+type cacheEntry struct {
+	ETag         string        `json:"etag,omitempty"`
+	LastModified string        `json:"last_modified,omitempty"`
+	FetchedAt    time.Time     `json:"fetched_at"`
+	OriginMaxAge time.Duration `json:"origin_max_age,omitempty"`
+}
+
+// CachedFetcher wraps HTTP fetching with an on-disk cache keyed by URL,
+// honoring ETag/Last-Modified for conditional requests, a response's
+// Cache-Control (no-store/private skip the cache entirely, max-age folds
+// into the freshness window) and MaxAge as the caller's own offline
+// freshness window. This mirrors Hugo's file-based GetOrCreateBytes cache:
+// it makes the spider usable offline, reproducible in CI, and cheap once
+// per-rule detail fetching fans out to hundreds of pages.
+type CachedFetcher struct {
+	// Client is used to make requests. If nil, http.DefaultClient is used.
+	Client *http.Client
+
+	// Dir is where cached bodies and metadata are stored. If empty, a
+	// "digso-wrap" folder under os.UserCacheDir (e.g. "~/.cache/digso-wrap/"
+	// on Linux, or under os.TempDir if there is none) is used.
+	Dir string
+
+	// MaxAge is how long a cached body is served without revalidating
+	// against the origin at all; when the origin also sent a Cache-Control
+	// max-age, the shorter of the two applies. Zero leaves freshness to the
+	// origin's max-age alone, and with neither set every fetch revalidates
+	// via a conditional request.
+	MaxAge time.Duration
+}
+
+// NewCachedFetcher returns a CachedFetcher rooted at dir, or at a
+// "digso-wrap" folder under the user cache directory (e.g.
+// "~/.cache/digso-wrap/" on Linux) if dir is empty.
+func NewCachedFetcher(dir string, maxAge time.Duration) *CachedFetcher {
+	if dir == "" {
+		dir = defaultCacheDir()
+	}
+	return &CachedFetcher{Dir: dir, MaxAge: maxAge}
+}
+
+func defaultCacheDir() string {
+	base, err := os.UserCacheDir()
+	if err != nil {
+		base = os.TempDir()
+	}
+	return filepath.Join(base, "digso-wrap")
+}
+
+func (f *CachedFetcher) client() *http.Client {
+	if f.Client != nil {
+		return f.Client
+	}
+	return http.DefaultClient
+}
+
+// Fetch returns url's body, serving it from the on-disk cache when it is
+// still fresh (see MaxAge), otherwise revalidating with the origin via
+// If-None-Match/If-Modified-Since before falling back to a full GET.
+func (f *CachedFetcher) Fetch(url string) ([]byte, error) {
+	dir := f.Dir
+	if dir == "" {
+		dir = defaultCacheDir()
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	key := cacheKey(url)
+	bodyPath := filepath.Join(dir, key+".body")
+	entry := readCacheEntry(filepath.Join(dir, key+".json"))
+
+	if entry != nil && fresh(entry, f.MaxAge) {
+		if body, err := os.ReadFile(bodyPath); err == nil {
+			return body, nil
+		}
+	}
+
+	request, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	if entry != nil {
+		if entry.ETag != "" {
+			request.Header.Set("If-None-Match", entry.ETag)
+		}
+		if entry.LastModified != "" {
+			request.Header.Set("If-Modified-Since", entry.LastModified)
+		}
+	}
+
+	response, err := f.client().Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	if response.StatusCode == http.StatusNotModified && entry != nil {
+		body, err := os.ReadFile(bodyPath)
+		if err == nil {
+			entry.FetchedAt = time.Now()
+			writeCacheEntry(filepath.Join(dir, key+".json"), entry)
+		}
+		return body, err
+	}
+
+	if response.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status fetching %s: %s", url, response.Status)
+	}
+
+	body, err := io.ReadAll(response.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	noStore, maxAge, hasMaxAge := parseCacheControl(response.Header.Get("Cache-Control"))
+	if noStore {
+		return body, nil
+	}
+
+	// A failure to persist the cache shouldn't fail the fetch: the caller
+	// still got a valid body, which is the whole point of this type.
+	if err := os.WriteFile(bodyPath, body, 0o644); err != nil {
+		return body, nil
+	}
+	entry = &cacheEntry{
+		ETag:         response.Header.Get("ETag"),
+		LastModified: response.Header.Get("Last-Modified"),
+		FetchedAt:    time.Now(),
+	}
+	if hasMaxAge {
+		entry.OriginMaxAge = maxAge
+	}
+	writeCacheEntry(filepath.Join(dir, key+".json"), entry)
+
+	return body, nil
+}
+
+// fresh reports whether entry is still within its freshness window: the
+// shorter of the caller's maxAge and the origin's advertised Cache-Control
+// max-age, whichever is set. A zero duration on both sides means "always
+// revalidate".
+func fresh(entry *cacheEntry, maxAge time.Duration) bool {
+	effective := maxAge
+	if entry.OriginMaxAge > 0 && (effective <= 0 || entry.OriginMaxAge < effective) {
+		effective = entry.OriginMaxAge
+	}
+	return effective > 0 && time.Since(entry.FetchedAt) < effective
+}
+
+// parseCacheControl extracts the no-store/private (don't persist the
+// response) and max-age directives from a Cache-Control header value.
+func parseCacheControl(header string) (noStore bool, maxAge time.Duration, hasMaxAge bool) {
+	for _, directive := range strings.Split(header, ",") {
+		directive = strings.TrimSpace(directive)
+		switch {
+		case strings.EqualFold(directive, "no-store"), strings.EqualFold(directive, "private"):
+			noStore = true
+		case strings.HasPrefix(strings.ToLower(directive), "max-age="):
+			seconds, err := strconv.Atoi(strings.TrimPrefix(strings.ToLower(directive), "max-age="))
+			if err == nil && seconds >= 0 {
+				maxAge = time.Duration(seconds) * time.Second
+				hasMaxAge = true
+			}
+		}
+	}
+	return noStore, maxAge, hasMaxAge
+}
+
+func cacheKey(url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return hex.EncodeToString(sum[:])
+}
+
+func readCacheEntry(path string) *cacheEntry {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil
+	}
+	var entry cacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil {
+		return nil
+	}
+	return &entry
+}
+
+func writeCacheEntry(path string, entry *cacheEntry) {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	_ = os.WriteFile(path, data, 0o644)
+}
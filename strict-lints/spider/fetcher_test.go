@@ -0,0 +1,151 @@
+package spider_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/digso/wrap/strict-lints/spider"
+)
+
+func TestCachedFetcherServesFromCacheWithinMaxAge(t *testing.T) {
+	var hits int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		fmt.Fprint(w, "hello")
+	}))
+	defer server.Close()
+
+	fetcher := spider.NewCachedFetcher(t.TempDir(), time.Hour)
+
+	for i := 0; i < 3; i++ {
+		body, err := fetcher.Fetch(server.URL)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if string(body) != "hello" {
+			t.Errorf("unexpected body: %q", body)
+		}
+	}
+
+	if hits != 1 {
+		t.Errorf("expected 1 origin hit within MaxAge, got %d", hits)
+	}
+}
+
+func TestCachedFetcherRevalidatesWithETag(t *testing.T) {
+	var hits int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.Header().Set("ETag", `"v1"`)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		fmt.Fprint(w, "hello")
+	}))
+	defer server.Close()
+
+	fetcher := spider.NewCachedFetcher(t.TempDir(), 0)
+
+	for i := 0; i < 2; i++ {
+		body, err := fetcher.Fetch(server.URL)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if string(body) != "hello" {
+			t.Errorf("unexpected body: %q", body)
+		}
+	}
+
+	if hits != 2 {
+		t.Errorf("expected 2 origin round trips (both revalidated), got %d", hits)
+	}
+}
+
+func TestCachedFetcherHonorsCacheControlNoStore(t *testing.T) {
+	var hits int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.Header().Set("Cache-Control", "no-store")
+		fmt.Fprint(w, "hello")
+	}))
+	defer server.Close()
+
+	fetcher := spider.NewCachedFetcher(t.TempDir(), time.Hour)
+
+	for i := 0; i < 2; i++ {
+		body, err := fetcher.Fetch(server.URL)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if string(body) != "hello" {
+			t.Errorf("unexpected body: %q", body)
+		}
+	}
+
+	if hits != 2 {
+		t.Errorf("expected no-store to bypass the cache on every fetch, got %d origin hits", hits)
+	}
+}
+
+func TestCachedFetcherHonorsCacheControlMaxAge(t *testing.T) {
+	var hits int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hits++
+		w.Header().Set("Cache-Control", "max-age=3600")
+		fmt.Fprint(w, "hello")
+	}))
+	defer server.Close()
+
+	// No caller-supplied MaxAge: freshness should come entirely from the
+	// origin's own Cache-Control max-age.
+	fetcher := spider.NewCachedFetcher(t.TempDir(), 0)
+
+	for i := 0; i < 2; i++ {
+		body, err := fetcher.Fetch(server.URL)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if string(body) != "hello" {
+			t.Errorf("unexpected body: %q", body)
+		}
+	}
+
+	if hits != 1 {
+		t.Errorf("expected origin max-age to serve the second fetch from cache, got %d origin hits", hits)
+	}
+}
+
+func TestCachedFetcherReturnsBodyWhenCacheWriteFails(t *testing.T) {
+	dir := t.TempDir()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, "hello")
+	}))
+	defer server.Close()
+
+	// Occupy the body's cache path with a directory, so the eventual
+	// os.WriteFile of the fetched body is guaranteed to fail regardless of
+	// the test's own file permissions.
+	sum := sha256.Sum256([]byte(server.URL))
+	key := hex.EncodeToString(sum[:])
+	if err := os.Mkdir(filepath.Join(dir, key+".body"), 0o755); err != nil {
+		t.Fatalf("failed to set up fixture: %v", err)
+	}
+
+	fetcher := spider.NewCachedFetcher(dir, time.Hour)
+	body, err := fetcher.Fetch(server.URL)
+	if err != nil {
+		t.Fatalf("expected Fetch to succeed despite a cache write failure, got: %v", err)
+	}
+	if string(body) != "hello" {
+		t.Errorf("unexpected body: %q", body)
+	}
+}
@@ -0,0 +1,54 @@
+package spider
+
+import "sort"
+
+// Lifecycle is the added/deprecated/removed diff between two scorecards
+// scraped from different Dart SDK releases. Rule names are sorted within
+// each slice.
+type Lifecycle struct {
+	Added      []string
+	Deprecated []string
+	Removed    []string
+}
+
+// DiffLifecycle compares an older and a newer Scorecard and reports which
+// rules appeared, newly went to deprecated status, or were removed between
+// them. Removed covers both rules that disappeared from the newer scorecard
+// and rules still listed there whose status newly became removed — the core
+// primitive needed to keep a strict-lints preset
+// current across Dart releases.
+func DiffLifecycle(older, newer Scorecard) Lifecycle {
+	oldByName := make(map[string]LintRule, len(older.Rules))
+	for _, rule := range older.Rules {
+		oldByName[rule.Name] = rule
+	}
+	newByName := make(map[string]LintRule, len(newer.Rules))
+	for _, rule := range newer.Rules {
+		newByName[rule.Name] = rule
+	}
+
+	var lifecycle Lifecycle
+	for name, rule := range newByName {
+		old, existed := oldByName[name]
+		if !existed {
+			lifecycle.Added = append(lifecycle.Added, name)
+			continue
+		}
+		if rule.Status == STATUS_DEPRECATED && old.Status != STATUS_DEPRECATED {
+			lifecycle.Deprecated = append(lifecycle.Deprecated, name)
+		}
+		if rule.Status == STATUS_REMOVED && old.Status != STATUS_REMOVED {
+			lifecycle.Removed = append(lifecycle.Removed, name)
+		}
+	}
+	for name := range oldByName {
+		if _, stillExists := newByName[name]; !stillExists {
+			lifecycle.Removed = append(lifecycle.Removed, name)
+		}
+	}
+
+	sort.Strings(lifecycle.Added)
+	sort.Strings(lifecycle.Deprecated)
+	sort.Strings(lifecycle.Removed)
+	return lifecycle
+}
@@ -0,0 +1,55 @@
+package spider_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/digso/wrap/strict-lints/spider"
+)
+
+func TestFetchRuleDetailsLifecycleProse(t *testing.T) {
+	rule := spider.LintRule{Name: "avoid_print"}
+	err := spider.FetchRuleDetails(&rule, fixtureFetcher{"testdata/detail-avoid_print.html"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if rule.Replacement != "avoid_print_strict" {
+		t.Errorf("unexpected Replacement: %q", rule.Replacement)
+	}
+	if rule.DeprecatedInDartSDK != "3.3" {
+		t.Errorf("unexpected DeprecatedInDartSDK: %q", rule.DeprecatedInDartSDK)
+	}
+	if rule.RemovedInDartSDK != "3.6" {
+		t.Errorf("unexpected RemovedInDartSDK: %q", rule.RemovedInDartSDK)
+	}
+}
+
+func TestDiffLifecycle(t *testing.T) {
+	older := spider.Scorecard{Rules: []spider.LintRule{
+		{Name: "always_declare_return_types"},
+		{Name: "avoid_print"},
+		{Name: "no_duplicate_case_values", Status: spider.STATUS_DEPRECATED},
+		{Name: "deprecated_member_use_from_same_package", Status: spider.STATUS_DEPRECATED},
+	}}
+	newer := spider.Scorecard{Rules: []spider.LintRule{
+		{Name: "always_declare_return_types"},
+		{Name: "avoid_print", Status: spider.STATUS_DEPRECATED},
+		{Name: "use_string_buffers"},
+		// Still listed on the site (e.g. under an "Incompatible"/removed
+		// section) instead of disappearing outright.
+		{Name: "deprecated_member_use_from_same_package", Status: spider.STATUS_REMOVED},
+	}}
+
+	diff := spider.DiffLifecycle(older, newer)
+
+	if !reflect.DeepEqual(diff.Added, []string{"use_string_buffers"}) {
+		t.Errorf("unexpected Added: %v", diff.Added)
+	}
+	if !reflect.DeepEqual(diff.Deprecated, []string{"avoid_print"}) {
+		t.Errorf("unexpected Deprecated: %v", diff.Deprecated)
+	}
+	if !reflect.DeepEqual(diff.Removed, []string{"deprecated_member_use_from_same_package", "no_duplicate_case_values"}) {
+		t.Errorf("unexpected Removed: %v", diff.Removed)
+	}
+}
@@ -1,7 +1,7 @@
 package spider
 
 import (
-	"net/http"
+	"bytes"
 	"strings"
 
 	"github.com/PuerkitoBio/goquery"
@@ -12,15 +12,16 @@ import (
 //
 //	"body > main#page-content > article > div.content > p"
 //
-// Each rule dom is parsed by the function parseRule.
-func ParseOfficialAPIs(url string) ([]LintRule, error) {
-	response, err := http.Get(url)
+// Each rule dom is parsed by the function parseRule. fetcher supplies url's
+// body; pass &HTTPFetcher{} for a plain GET, or a *CachedFetcher to reuse a
+// prior run's response.
+func ParseOfficialAPIs(url string, fetcher Fetcher) ([]LintRule, error) {
+	body, err := fetcher.Fetch(url)
 	if err != nil {
 		return nil, err
 	}
-	defer response.Body.Close()
 
-	document, err := goquery.NewDocumentFromReader(response.Body)
+	document, err := goquery.NewDocumentFromReader(bytes.NewReader(body))
 	if err != nil {
 		return nil, err
 	}
@@ -85,7 +86,8 @@ func parseName(selection *goquery.Selection) string {
 }
 
 // Parse tag from such dom structure,
-// and update into the given rule struct with its pointer:
+// and update into the given rule struct with its pointer,
+// dispatching on the image file name via TagRegistry:
 //
 //	<a href="/tools/linter-rules#tag-area_title">
 //		<img src="/assets/img/tools/linter/tag-name.svg" alt="xxx" />
@@ -108,17 +110,10 @@ func ParseTags(selection *goquery.Selection, rule *LintRule) {
 	if !exist || !strings.HasPrefix(src, prefix) {
 		return
 	}
-	fileName := strings.TrimLeft(src, strings.TrimSpace(prefix))
+	fileName := strings.TrimPrefix(src, prefix)
 	fileName = strings.TrimSuffix(fileName, ".svg")
-	switch fileName {
-	case "has-fix":
-		rule.HasFix = true
-	case "style-core": // todo fix bug here cannot parse.
-		rule.Core = true
-	case "style-flutter": // todo fix bug here cannot parse.
-		rule.Flutter = true
-	case "style-recommended": // todo fix bug here cannot parse.
-		rule.Recommended = true
+	if apply, ok := TagRegistry[fileName]; ok {
+		apply(rule)
 	}
 }
 
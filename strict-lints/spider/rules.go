@@ -24,6 +24,23 @@ type LintRule struct {
 	Core        bool
 	Flutter     bool
 	Recommended bool
+
+	// 以下字段来自 https://dart.dev/tools/linter-rules/<name> 详情页，
+	// 由 FetchRuleDetails 填充，ParseOfficialAPIs 不会设置它们。
+	Description  string
+	Details      string
+	SinceDartSDK string // the Dart SDK version the rule was introduced in.
+	State        string
+	Incompatible []string
+	BadGood      []Example
+
+	// DeprecatedInDartSDK and RemovedInDartSDK record the Dart SDK version
+	// a deprecated/removed rule's status changed, when the detail page's
+	// prose states one. Replacement is the successor rule named by a
+	// "Deprecated: use X" sentence, if any.
+	DeprecatedInDartSDK string
+	RemovedInDartSDK    string
+	Replacement         string
 }
 
 func (rule *LintRule) String() string {
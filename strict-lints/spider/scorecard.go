@@ -0,0 +1,156 @@
+package spider
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Detail identifies a column that RenderMarkdown can include in its table,
+// letting callers pick a subset instead of always rendering every column.
+type Detail int
+
+const (
+	DetailFix Detail = iota
+	DetailCore
+	DetailFlutter
+	DetailRecommended
+	DetailStatus
+)
+
+// header returns the Markdown column title for a Detail.
+func (d Detail) header() string {
+	switch d {
+	case DetailFix:
+		return "Fix"
+	case DetailCore:
+		return "Core"
+	case DetailFlutter:
+		return "Flutter"
+	case DetailRecommended:
+		return "Recommended"
+	case DetailStatus:
+		return "Status"
+	default:
+		return ""
+	}
+}
+
+// cell renders the Markdown cell value for a Detail against a single rule.
+func (d Detail) cell(rule LintRule) string {
+	switch d {
+	case DetailFix:
+		return checkmark(rule.HasFix, "💡")
+	case DetailCore:
+		return checkmark(rule.Core, "✅")
+	case DetailFlutter:
+		return checkmark(rule.Flutter, "✅")
+	case DetailRecommended:
+		return checkmark(rule.Recommended, "✅")
+	case DetailStatus:
+		return string(rule.Status)
+	default:
+		return ""
+	}
+}
+
+func checkmark(set bool, mark string) string {
+	if set {
+		return mark
+	}
+	return ""
+}
+
+// Scorecard mirrors the canonical scorecard the Dart linter project itself
+// publishes: a table of every rule against the rule sets and fixes it
+// belongs to.
+type Scorecard struct {
+	Rules []LintRule
+}
+
+// RenderMarkdown renders the scorecard as a Markdown table, with a "Rule"
+// column first followed by one column per entry in details, in order. Rows
+// are sorted by rule-set membership (Core, then Flutter, then Recommended,
+// then unclassified) and by name within a membership group. A totals row is
+// appended at the bottom, counting set membership and fixes across all
+// rules regardless of which details are requested.
+func (s Scorecard) RenderMarkdown(details []Detail) string {
+	rules := append([]LintRule(nil), s.Rules...)
+	sort.SliceStable(rules, func(i, j int) bool {
+		ri, rj := membershipRank(rules[i]), membershipRank(rules[j])
+		if ri != rj {
+			return ri < rj
+		}
+		return rules[i].Name < rules[j].Name
+	})
+
+	var b strings.Builder
+
+	b.WriteString("| Rule ")
+	for _, d := range details {
+		fmt.Fprintf(&b, "| %s ", d.header())
+	}
+	b.WriteString("|\n")
+
+	b.WriteString("| --- ")
+	for range details {
+		b.WriteString("| --- ")
+	}
+	b.WriteString("|\n")
+
+	var core, flutter, recommended, fixes int
+	for _, rule := range rules {
+		fmt.Fprintf(&b, "| %s ", rule.Name)
+		for _, d := range details {
+			fmt.Fprintf(&b, "| %s ", d.cell(rule))
+		}
+		b.WriteString("|\n")
+
+		if rule.Core {
+			core++
+		}
+		if rule.Flutter {
+			flutter++
+		}
+		if rule.Recommended {
+			recommended++
+		}
+		if rule.HasFix {
+			fixes++
+		}
+	}
+
+	fmt.Fprintf(&b, "| **Total: %d** ", len(rules))
+	for _, d := range details {
+		switch d {
+		case DetailFix:
+			fmt.Fprintf(&b, "| %d ", fixes)
+		case DetailCore:
+			fmt.Fprintf(&b, "| %d ", core)
+		case DetailFlutter:
+			fmt.Fprintf(&b, "| %d ", flutter)
+		case DetailRecommended:
+			fmt.Fprintf(&b, "| %d ", recommended)
+		default:
+			b.WriteString("| ")
+		}
+	}
+	b.WriteString("|\n")
+
+	return b.String()
+}
+
+// membershipRank orders rules by rule-set membership: Core first, then
+// Flutter, then Recommended, then rules in no set.
+func membershipRank(rule LintRule) int {
+	switch {
+	case rule.Core:
+		return 0
+	case rule.Flutter:
+		return 1
+	case rule.Recommended:
+		return 2
+	default:
+		return 3
+	}
+}
@@ -0,0 +1,28 @@
+package spider_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/digso/wrap/strict-lints/spider"
+)
+
+func TestScorecardRenderMarkdown(t *testing.T) {
+	card := spider.Scorecard{Rules: []spider.LintRule{
+		{Name: "avoid_print", Flutter: true},
+		{Name: "always_declare_return_types", Core: true, HasFix: true},
+	}}
+
+	out := card.RenderMarkdown([]spider.Detail{spider.DetailFix, spider.DetailCore, spider.DetailFlutter})
+
+	lines := strings.Split(strings.TrimRight(out, "\n"), "\n")
+	if len(lines) != 5 {
+		t.Fatalf("expected 5 lines (header, divider, 2 rows, total), got %d:\n%s", len(lines), out)
+	}
+	if !strings.HasPrefix(lines[2], "| always_declare_return_types ") {
+		t.Errorf("expected Core rule sorted first, got %q", lines[2])
+	}
+	if !strings.Contains(lines[4], "**Total: 2**") {
+		t.Errorf("expected totals row, got %q", lines[4])
+	}
+}
@@ -0,0 +1,18 @@
+package spider
+
+// TagRegistry maps a tag image's file name (without its .svg extension) to
+// the mutation ParseTags applies to the rule it was found on. RegisterTag
+// lets callers add new tag SVGs (e.g. a future "style-dart3" or "pub-score")
+// without editing ParseTags itself.
+var TagRegistry = map[string]func(*LintRule){
+	"has-fix":           func(rule *LintRule) { rule.HasFix = true },
+	"style-core":        func(rule *LintRule) { rule.Core = true },
+	"style-flutter":     func(rule *LintRule) { rule.Flutter = true },
+	"style-recommended": func(rule *LintRule) { rule.Recommended = true },
+}
+
+// RegisterTag adds or replaces the mutation applied when a tag image named
+// imgName (without its .svg extension) is encountered by ParseTags.
+func RegisterTag(imgName string, apply func(*LintRule)) {
+	TagRegistry[imgName] = apply
+}
@@ -0,0 +1,43 @@
+package spider_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/digso/wrap/strict-lints/spider"
+)
+
+func TestParseTagsFixtures(t *testing.T) {
+	tests := []struct {
+		fixture string
+		check   func(rule spider.LintRule) bool
+	}{
+		{"testdata/tag-has-fix.html", func(rule spider.LintRule) bool { return rule.HasFix }},
+		{"testdata/tag-style-core.html", func(rule spider.LintRule) bool { return rule.Core }},
+		{"testdata/tag-style-flutter.html", func(rule spider.LintRule) bool { return rule.Flutter }},
+		{"testdata/tag-style-recommended.html", func(rule spider.LintRule) bool { return rule.Recommended }},
+	}
+
+	for _, test := range tests {
+		t.Run(test.fixture, func(t *testing.T) {
+			file, err := os.Open(test.fixture)
+			if err != nil {
+				t.Fatalf("failed to open fixture: %v", err)
+			}
+			defer file.Close()
+
+			document, err := goquery.NewDocumentFromReader(file)
+			if err != nil {
+				t.Fatalf("failed to parse fixture: %v", err)
+			}
+
+			var rule spider.LintRule
+			spider.ParseTags(document.Find("a").First(), &rule)
+
+			if !test.check(rule) {
+				t.Errorf("ParseTags did not set the expected field for %s, got %+v", test.fixture, rule)
+			}
+		})
+	}
+}